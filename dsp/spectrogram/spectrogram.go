@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package spectrogram provides short-time Fourier transform (STFT) and
+// spectrogram computation built on top of dsp/window and dsp/fourier.
+package spectrogram
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+	"gonum.org/v1/gonum/dsp/window"
+)
+
+// STFT computes the short-time Fourier transform of a signal by sliding
+// a window of length NFFT across the signal with the given hop size,
+// applying the window in place to a reusable buffer and running a real
+// FFT on each frame.
+//
+// An STFT must be created with NewSTFT; the zero value is not usable.
+type STFT struct {
+	nfft int
+	hop  int
+	win  window.Values
+
+	fft *fourier.FFT
+	buf []float64
+}
+
+// NewSTFT returns an STFT that analyzes frames of length nfft, advancing
+// hop samples between frames. win, if non-nil, is the analysis window
+// applied to each frame in place; it must have length nfft. The overlap
+// between consecutive frames is nfft-hop.
+//
+// NewSTFT panics if nfft is not positive, if hop is not in (0, nfft], or
+// if win is non-nil and len(win) != nfft.
+func NewSTFT(nfft, hop int, win window.Values) *STFT {
+	if nfft <= 0 {
+		panic("spectrogram: nfft must be positive")
+	}
+	if hop <= 0 || hop > nfft {
+		panic("spectrogram: hop must be in (0, nfft]")
+	}
+	if win != nil && len(win) != nfft {
+		panic("spectrogram: window length mismatch")
+	}
+	return &STFT{
+		nfft: nfft,
+		hop:  hop,
+		win:  win,
+		fft:  fourier.NewFFT(nfft),
+		buf:  make([]float64, nfft),
+	}
+}
+
+// NFFT returns the frame length used by s.
+func (s *STFT) NFFT() int { return s.nfft }
+
+// Hop returns the number of samples advanced between successive frames.
+func (s *STFT) Hop() int { return s.hop }
+
+// NumFrames returns the number of frames that Spectrogram will produce
+// for a signal of length n.
+func (s *STFT) NumFrames(n int) int {
+	if n < s.nfft {
+		return 0
+	}
+	return (n-s.nfft)/s.hop + 1
+}
+
+// Spectrogram computes the complex short-time Fourier transform of signal,
+// returning one set of Fourier coefficients per frame. Each row has
+// nfft/2+1 elements, following the convention of dsp/fourier.FFT.
+func (s *STFT) Spectrogram(signal []float64) [][]complex128 {
+	n := s.NumFrames(len(signal))
+	out := make([][]complex128, n)
+	for i := range out {
+		frame := s.nextFrame(signal, i)
+		out[i] = s.fft.Coefficients(nil, frame)
+	}
+	return out
+}
+
+// Magnitude computes the magnitude spectrogram of signal, equivalent to
+// taking the element-wise absolute value of the result of Spectrogram but
+// reusing a single buffer for the intermediate complex coefficients instead
+// of allocating them for every frame.
+func (s *STFT) Magnitude(signal []float64) [][]float64 {
+	n := s.NumFrames(len(signal))
+	out := make([][]float64, n)
+	var coeff []complex128
+	for i := range out {
+		frame := s.nextFrame(signal, i)
+		coeff = s.fft.Coefficients(coeff, frame)
+		row := make([]float64, len(coeff))
+		for j, c := range coeff {
+			row[j] = cmplx.Abs(c)
+		}
+		out[i] = row
+	}
+	return out
+}
+
+// nextFrame copies the i-th frame of signal into s.buf, applies the
+// analysis window in place and returns it. The returned slice aliases
+// s.buf and is only valid until the next call to nextFrame.
+func (s *STFT) nextFrame(signal []float64, i int) []float64 {
+	start := i * s.hop
+	copy(s.buf, signal[start:start+s.nfft])
+	s.win.Transform(s.buf)
+	return s.buf
+}
+
+// Streamer computes an STFT incrementally from frames of samples pushed
+// by the caller, allowing long or live signals to be processed without
+// allocating the full spectrogram up front.
+//
+// A Streamer must be created with NewStreamer; the zero value is not
+// usable.
+type Streamer struct {
+	stft    *STFT
+	pending []float64
+}
+
+// NewStreamer returns a Streamer that uses s to analyze pushed samples.
+func (s *STFT) NewStreamer() *Streamer {
+	return &Streamer{stft: s}
+}
+
+// Push appends samples to the streamer's pending buffer and returns the
+// complex Fourier coefficients of every frame that is now complete. The
+// samples that remain after the last complete frame are retained for the
+// next call to Push.
+func (st *Streamer) Push(samples []float64) [][]complex128 {
+	st.pending = append(st.pending, samples...)
+
+	s := st.stft
+	n := s.NumFrames(len(st.pending))
+	out := make([][]complex128, n)
+	for i := range out {
+		frame := s.nextFrame(st.pending, i)
+		out[i] = s.fft.Coefficients(nil, frame)
+	}
+
+	if n > 0 {
+		consumed := (n-1)*s.hop + s.hop
+		if consumed > len(st.pending) {
+			consumed = len(st.pending)
+		}
+		st.pending = append(st.pending[:0], st.pending[consumed:]...)
+	}
+	return out
+}
+
+// InverseSTFT reconstructs a real signal from a sequence of complex
+// STFT frames using the overlap-add method.
+//
+// An InverseSTFT must be created with NewInverseSTFT; the zero value is
+// not usable.
+type InverseSTFT struct {
+	nfft  int
+	hop   int
+	synth window.Values
+
+	fft *fourier.FFT
+}
+
+// NewInverseSTFT returns an InverseSTFT that reconstructs signals analyzed
+// with frame length nfft and hop size hop. synth, if non-nil, is the
+// synthesis window applied to each reconstructed frame before overlap-add;
+// it must have length nfft.
+func NewInverseSTFT(nfft, hop int, synth window.Values) *InverseSTFT {
+	if nfft <= 0 {
+		panic("spectrogram: nfft must be positive")
+	}
+	if hop <= 0 || hop > nfft {
+		panic("spectrogram: hop must be in (0, nfft]")
+	}
+	if synth != nil && len(synth) != nfft {
+		panic("spectrogram: window length mismatch")
+	}
+	return &InverseSTFT{
+		nfft:  nfft,
+		hop:   hop,
+		synth: synth,
+		fft:   fourier.NewFFT(nfft),
+	}
+}
+
+// Reconstruct inverts each frame of frames back to a real-valued signal
+// and overlap-adds the results, normalizing by the sum of squared
+// synthesis window values so that a synthesized signal processed with a
+// matching analysis window reconstructs the original amplitude.
+func (s *InverseSTFT) Reconstruct(frames [][]complex128) []float64 {
+	if len(frames) == 0 {
+		return nil
+	}
+	total := (len(frames)-1)*s.hop + s.nfft
+	out := make([]float64, total)
+	norm := make([]float64, total)
+
+	var seq []float64
+	for i, coeff := range frames {
+		seq = s.fft.Sequence(seq, coeff)
+		for j := range seq {
+			seq[j] /= float64(s.nfft)
+		}
+		start := i * s.hop
+		for j, v := range seq {
+			w := 1.0
+			if s.synth != nil {
+				w = s.synth[j]
+			}
+			out[start+j] += v * w
+			norm[start+j] += w * w
+		}
+	}
+	for i, w := range norm {
+		if w > 0 {
+			out[i] /= w
+		}
+	}
+	return out
+}
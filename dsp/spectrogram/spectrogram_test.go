@@ -0,0 +1,144 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectrogram
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"gonum.org/v1/gonum/dsp/window"
+)
+
+func sampleSignal(n int) []float64 {
+	sig := make([]float64, n)
+	for i := range sig {
+		sig[i] = math.Sin(2*math.Pi*0.1*float64(i)) + 0.5*math.Sin(2*math.Pi*0.25*float64(i))
+	}
+	return sig
+}
+
+func TestSTFTInverseRoundTripNoOverlap(t *testing.T) {
+	const nfft = 8
+	signal := sampleSignal(64)
+
+	stft := NewSTFT(nfft, nfft, nil)
+	frames := stft.Spectrogram(signal)
+
+	istft := NewInverseSTFT(nfft, nfft, nil)
+	got := istft.Reconstruct(frames)
+
+	if len(got) != len(signal) {
+		t.Fatalf("reconstructed length = %d, want %d", len(got), len(signal))
+	}
+	for i, want := range signal {
+		if math.Abs(got[i]-want) > 1e-9 {
+			t.Errorf("sample %d: got %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestSTFTInverseRoundTripOverlapAdd(t *testing.T) {
+	const nfft = 8
+	const hop = 4
+	signal := sampleSignal(64)
+
+	// A symmetric taper with no exact zeros, so InverseSTFT's per-sample
+	// normalization by the summed squared window is always well-defined.
+	win := window.Values{0.1, 0.5, 0.9, 1, 1, 0.9, 0.5, 0.1}
+
+	stft := NewSTFT(nfft, hop, win)
+	frames := stft.Spectrogram(signal)
+
+	istft := NewInverseSTFT(nfft, hop, win)
+	got := istft.Reconstruct(frames)
+
+	// Away from the edges, where overlap-add has full window support,
+	// reconstruction should recover the original signal closely.
+	for i := nfft; i < len(signal)-nfft; i++ {
+		if math.Abs(got[i]-signal[i]) > 1e-6 {
+			t.Errorf("sample %d: got %v, want %v", i, got[i], signal[i])
+		}
+	}
+}
+
+// TestInverseSTFTNormalization pins Reconstruct's 1/nfft scaling against a
+// closed-form spectrum, independent of the forward STFT path: the real-FFT
+// coefficients of a unit impulse at sample 0 are all 1+0i, and gonum's
+// unnormalized inverse FFT of that constant spectrum is nfft times the
+// impulse. Reconstruct must undo that factor on its own.
+func TestInverseSTFTNormalization(t *testing.T) {
+	const nfft = 8
+	coeff := make([]complex128, nfft/2+1)
+	for i := range coeff {
+		coeff[i] = 1
+	}
+
+	istft := NewInverseSTFT(nfft, nfft, nil)
+	got := istft.Reconstruct([][]complex128{coeff})
+
+	want := make([]float64, nfft)
+	want[0] = 1
+	for i, w := range want {
+		if math.Abs(got[i]-w) > 1e-9 {
+			t.Errorf("sample %d: got %v, want %v", i, got[i], w)
+		}
+	}
+}
+
+func TestMagnitudeMatchesSpectrogram(t *testing.T) {
+	const nfft = 16
+	const hop = 8
+	signal := sampleSignal(48)
+
+	stft := NewSTFT(nfft, hop, nil)
+	coeffs := stft.Spectrogram(signal)
+	mags := stft.Magnitude(signal)
+
+	if len(coeffs) != len(mags) {
+		t.Fatalf("got %d magnitude frames, want %d", len(mags), len(coeffs))
+	}
+	for i, row := range coeffs {
+		for j, c := range row {
+			want := cmplx.Abs(c)
+			if math.Abs(mags[i][j]-want) > 1e-12 {
+				t.Errorf("frame %d bin %d: got magnitude %v, want %v", i, j, mags[i][j], want)
+			}
+		}
+	}
+}
+
+func TestStreamerMatchesBatch(t *testing.T) {
+	const nfft = 16
+	const hop = 8
+	signal := sampleSignal(50)
+
+	stft := NewSTFT(nfft, hop, nil)
+	want := stft.Spectrogram(signal)
+
+	streamStft := NewSTFT(nfft, hop, nil)
+	streamer := streamStft.NewStreamer()
+	var got [][]complex128
+	// Push the signal in small, irregular chunks to exercise buffering
+	// across multiple Push calls.
+	for i := 0; i < len(signal); i += 7 {
+		end := i + 7
+		if end > len(signal) {
+			end = len(signal)
+		}
+		got = append(got, streamer.Push(signal[i:end])...)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d streamed frames, want %d", len(got), len(want))
+	}
+	for i, row := range want {
+		for j, c := range row {
+			if cmplx.Abs(got[i][j]-c) > 1e-9 {
+				t.Errorf("frame %d bin %d: got %v, want %v", i, j, got[i][j], c)
+			}
+		}
+	}
+}
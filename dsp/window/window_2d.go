@@ -0,0 +1,189 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import "math"
+
+// Transform2D applies win separably to data, a rows-by-cols matrix stored
+// row-major as a flat slice, weighting each element by the product of the
+// 1-D window evaluated along its row and along its column, and returns
+// the result in place.
+//
+// Transform2D panics if len(data) != rows*cols.
+func Transform2D(win func([]float64) []float64, data []float64, rows, cols int) []float64 {
+	if len(data) != rows*cols {
+		panic("window: data length does not match rows*cols")
+	}
+	rowW := NewValues(win, cols)
+	colW := NewValues(win, rows)
+	for r := 0; r < rows; r++ {
+		row := data[r*cols : (r+1)*cols]
+		rowW.Transform(row)
+		cw := colW[r]
+		for c := range row {
+			row[c] *= cw
+		}
+	}
+	return data
+}
+
+// Transform2DComplex applies win separably to data, a rows-by-cols matrix
+// stored row-major as a flat slice, weighting each element by the product
+// of the 1-D window evaluated along its row and along its column, and
+// returns the result in place.
+//
+// Transform2DComplex panics if len(data) != rows*cols.
+func Transform2DComplex(win func([]float64) []float64, data []complex128, rows, cols int) []complex128 {
+	if len(data) != rows*cols {
+		panic("window: data length does not match rows*cols")
+	}
+	rowW := NewValues(win, cols)
+	colW := NewValues(win, rows)
+	for r := 0; r < rows; r++ {
+		row := data[r*cols : (r+1)*cols]
+		rowW.TransformComplex(row)
+		cw := colW[r]
+		for c, v := range row {
+			row[c] = complex(cw*real(v), cw*imag(v))
+		}
+	}
+	return data
+}
+
+// NewValues2D returns a rows-by-cols matrix of weights corresponding to
+// the separable application of the provided 1-D window function along
+// both axes, mirroring NewValues.
+func NewValues2D(window func([]float64) []float64, rows, cols int) [][]float64 {
+	rowW := NewValues(window, cols)
+	colW := NewValues(window, rows)
+	v := make([][]float64, rows)
+	for r := range v {
+		row := append([]float64(nil), rowW...)
+		cw := colW[r]
+		for c := range row {
+			row[c] *= cw
+		}
+		v[r] = row
+	}
+	return v
+}
+
+// RadialProfile is a 1-D window expressed as a function of normalized
+// radius, where r=0 is the center of the window and r=1 is its edge. It
+// should return 0 for r>=1.
+type RadialProfile func(r float64) float64
+
+// Radial returns the RadialProfile of the Gaussian window using the value
+// of the receiver as the sigma parameter: w(r) = exp(-0.5*(r/σ)²), clipped
+// to 0 for r>=1 so that it honors the RadialProfile contract despite the
+// Gaussian's tail never reaching exactly 0.
+func (g Gaussian) Radial(r float64) float64 {
+	if r >= 1 {
+		return 0
+	}
+	return math.Exp(-0.5 * math.Pow(r/g.Sigma, 2))
+}
+
+// Radial returns the RadialProfile of the Tukey window using the value of
+// the receiver as the Alpha parameter: flat for r <= 1-α, a cosine taper
+// to 0 over 1-α < r <= 1, and 0 beyond.
+func (t Tukey) Radial(r float64) float64 {
+	alpha := t.Alpha
+	if alpha <= 0 {
+		if r < 1 {
+			return 1
+		}
+		return 0
+	}
+	if alpha > 1 {
+		alpha = 1
+	}
+	edge := 1 - alpha
+	switch {
+	case r <= edge:
+		return 1
+	case r <= 1:
+		return 0.5 * (1 + math.Cos(math.Pi*(r-edge)/alpha))
+	default:
+		return 0
+	}
+}
+
+// Transform2DRadial applies profile to data, a rows-by-cols matrix stored
+// row-major as a flat slice, as a function of each element's distance
+// from the matrix center, normalized independently along each axis so
+// that r=1 at the midpoint of every edge. This produces a taper with
+// elliptical support, circular when rows == cols, as opposed to the
+// rectangular taper of Transform2D, suited to
+// 2-D FFTs of images or 2-D spectrograms. The result is returned in
+// place.
+//
+// Transform2DRadial panics if len(data) != rows*cols.
+func Transform2DRadial(profile RadialProfile, data []float64, rows, cols int) []float64 {
+	if len(data) != rows*cols {
+		panic("window: data length does not match rows*cols")
+	}
+	cy, ry := axisCenter(rows)
+	cx, rx := axisCenter(cols)
+	for r := 0; r < rows; r++ {
+		dy := (float64(r) - cy) / ry
+		row := data[r*cols : (r+1)*cols]
+		for c := range row {
+			dx := (float64(c) - cx) / rx
+			row[c] *= profile(math.Hypot(dy, dx))
+		}
+	}
+	return data
+}
+
+// Transform2DRadialComplex applies profile to data, a rows-by-cols matrix
+// stored row-major as a flat slice, as a function of each element's
+// distance from the matrix center; see Transform2DRadial for details. The
+// result is returned in place.
+//
+// Transform2DRadialComplex panics if len(data) != rows*cols.
+func Transform2DRadialComplex(profile RadialProfile, data []complex128, rows, cols int) []complex128 {
+	if len(data) != rows*cols {
+		panic("window: data length does not match rows*cols")
+	}
+	cy, ry := axisCenter(rows)
+	cx, rx := axisCenter(cols)
+	for r := 0; r < rows; r++ {
+		dy := (float64(r) - cy) / ry
+		row := data[r*cols : (r+1)*cols]
+		for c, v := range row {
+			dx := (float64(c) - cx) / rx
+			w := profile(math.Hypot(dy, dx))
+			row[c] = complex(w*real(v), w*imag(v))
+		}
+	}
+	return data
+}
+
+// NewValues2DRadial returns a rows-by-cols matrix of weights given by
+// profile evaluated at each element's normalized radial distance from the
+// matrix center, mirroring NewValues2D.
+func NewValues2DRadial(profile RadialProfile, rows, cols int) [][]float64 {
+	flat := ones(rows * cols)
+	Transform2DRadial(profile, flat, rows, cols)
+	v := make([][]float64, rows)
+	for r := range v {
+		v[r] = flat[r*cols : (r+1)*cols]
+	}
+	return v
+}
+
+// axisCenter returns the index of the center of an axis of length n and the
+// distance from that center to the nearest edge, used to normalize radial
+// distances along the axis to [0, 1]. The distance is clamped to 1 only when
+// n == 1, to avoid division by zero.
+func axisCenter(n int) (center, halfWidth float64) {
+	center = float64(n-1) / 2
+	halfWidth = center
+	if halfWidth == 0 {
+		halfWidth = 1
+	}
+	return center, halfWidth
+}
@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAxisCenter(t *testing.T) {
+	for _, test := range []struct {
+		n                    int
+		wantCenter, wantHalf float64
+	}{
+		{1, 0, 1},
+		{2, 0.5, 0.5}, // halfWidth must not be clamped up to 1 here
+		{3, 1, 1},
+		{5, 2, 2},
+	} {
+		center, half := axisCenter(test.n)
+		if center != test.wantCenter {
+			t.Errorf("axisCenter(%d) center = %v, want %v", test.n, center, test.wantCenter)
+		}
+		if half != test.wantHalf {
+			t.Errorf("axisCenter(%d) halfWidth = %v, want %v", test.n, half, test.wantHalf)
+		}
+	}
+}
+
+func TestTransform2DSeparable(t *testing.T) {
+	const rows, cols = 3, 4
+	data := ones(rows * cols)
+	Transform2D(Bartlett, data, rows, cols)
+
+	want := [][]float64{
+		{0, 0, 0, 0},
+		{0, 2.0 / 3, 2.0 / 3, 0},
+		{0, 0, 0, 0},
+	}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			got := data[r*cols+c]
+			if math.Abs(got-want[r][c]) > 1e-9 {
+				t.Errorf("(%d,%d): got %v, want %v", r, c, got, want[r][c])
+			}
+		}
+	}
+}
+
+func TestNewValues2DMatchesTransform2D(t *testing.T) {
+	const rows, cols = 3, 4
+	flat := ones(rows * cols)
+	Transform2D(Bartlett, flat, rows, cols)
+
+	v := NewValues2D(Bartlett, rows, cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			want := flat[r*cols+c]
+			if math.Abs(v[r][c]-want) > 1e-9 {
+				t.Errorf("(%d,%d): got %v, want %v", r, c, v[r][c], want)
+			}
+		}
+	}
+}
+
+func TestTransform2DRadialSingleElement(t *testing.T) {
+	data := []float64{1}
+	Transform2DRadial(Gaussian{Sigma: 1}.Radial, data, 1, 1)
+	if data[0] != 1 {
+		t.Errorf("1x1 radial transform = %v, want 1 (radius 0 at the only element)", data[0])
+	}
+}
+
+func TestGaussianRadialClippedAtEdge(t *testing.T) {
+	g := Gaussian{Sigma: 1}
+	if w := g.Radial(1); w != 0 {
+		t.Errorf("Gaussian.Radial(1) = %v, want 0", w)
+	}
+	if w := g.Radial(1.5); w != 0 {
+		t.Errorf("Gaussian.Radial(1.5) = %v, want 0", w)
+	}
+	if w := g.Radial(0); w != 1 {
+		t.Errorf("Gaussian.Radial(0) = %v, want 1", w)
+	}
+	if w := g.Radial(0.999); w <= 0 || w >= 1 {
+		t.Errorf("Gaussian.Radial(0.999) = %v, want a value in (0, 1)", w)
+	}
+}
+
+func TestTukeyRadial(t *testing.T) {
+	tk := Tukey{Alpha: 0.5}
+	if w := tk.Radial(0); w != 1 {
+		t.Errorf("Tukey.Radial(0) = %v, want 1 (flat center)", w)
+	}
+	if w := tk.Radial(1); math.Abs(w) > 1e-12 {
+		t.Errorf("Tukey.Radial(1) = %v, want 0 (edge)", w)
+	}
+	if w := tk.Radial(1.5); w != 0 {
+		t.Errorf("Tukey.Radial(1.5) = %v, want 0 (beyond edge)", w)
+	}
+	// Within the flat region (r <= 1-alpha = 0.5) the profile stays at 1.
+	if w := tk.Radial(0.4); w != 1 {
+		t.Errorf("Tukey.Radial(0.4) = %v, want 1 (still in flat region)", w)
+	}
+}
+
+func TestTukeyRadialZeroAlphaIsRectangular(t *testing.T) {
+	tk := Tukey{Alpha: 0}
+	if w := tk.Radial(0); w != 1 {
+		t.Errorf("Tukey{Alpha:0}.Radial(0) = %v, want 1", w)
+	}
+	if w := tk.Radial(0.999); w != 1 {
+		t.Errorf("Tukey{Alpha:0}.Radial(0.999) = %v, want 1", w)
+	}
+	if w := tk.Radial(1); w != 0 {
+		t.Errorf("Tukey{Alpha:0}.Radial(1) = %v, want 0", w)
+	}
+	if w := tk.Radial(1.5); w != 0 {
+		t.Errorf("Tukey{Alpha:0}.Radial(1.5) = %v, want 0", w)
+	}
+}
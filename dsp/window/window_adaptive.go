@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import "math"
+
+// Adaptive chooses a window length for a streaming STFT by watching how
+// surprising each new frame's energy is relative to the recent history of
+// frame-to-frame energy changes, using the φ-accrual scheme of Hayashibara
+// et al., "The φ Accrual Failure Detector" (2004), adapted from failure
+// detection to time/frequency resolution trade-off.
+//
+// A running estimate of the mean μ and variance σ² of the inter-frame
+// log-energy difference is maintained with Welford's online algorithm over
+// every difference observed since the first call to Next; older
+// differences are neither dropped nor down-weighted.
+// For each new difference x, a suspicion value
+//
+//	φ = -log10(1 - Φ((x-μ)/σ))
+//
+// is computed, where Φ is the standard normal CDF. φ grows without bound
+// as x becomes less and less likely under the running Gaussian model.
+// When φ exceeds Threshold the next window is shrunk towards MinN, trading
+// frequency resolution for time resolution around the transient; otherwise
+// it is relaxed back towards MaxN by Smoothing, recovering frequency
+// resolution during stationary stretches of the signal.
+//
+// An Adaptive must be created with a Base window, MinN, MaxN and
+// Threshold set; the zero value of the rest of the fields starts the
+// window at MaxN with no history.
+type Adaptive struct {
+	// Base is the underlying window shape used to generate coefficients
+	// for the chosen length.
+	Base Window
+	// MinN and MaxN bound the window length chosen by Next.
+	MinN, MaxN int
+	// Threshold is the surprise value above which the window length is
+	// shrunk towards MinN.
+	Threshold float64
+	// Smoothing is the fraction, in (0, 1], of the distance to MinN or
+	// MaxN that the window length moves on each call to Next.
+	Smoothing float64
+	// Mode is the Mode used to generate coefficients from Base; the zero
+	// value is Symmetric.
+	Mode Mode
+
+	n          int
+	havePrev   bool
+	prevEnergy float64
+	mean       float64
+	m2         float64
+	count      float64
+}
+
+// Next reports the window length and coefficients to use for the next
+// frame, given frameEnergy, the log-energy of that frame.
+func (a *Adaptive) Next(frameEnergy float64) (n int, w Values) {
+	if a.n == 0 {
+		a.n = a.MaxN
+	}
+	if a.havePrev {
+		a.observe(frameEnergy - a.prevEnergy)
+	}
+	a.prevEnergy = frameEnergy
+	a.havePrev = true
+	return a.n, Values(a.Base.Coefficients(a.n, a.Mode))
+}
+
+// observe updates the running mean and variance of the inter-frame
+// log-energy difference with Welford's online algorithm, computes the
+// resulting surprise value, and adjusts a.n towards MinN or MaxN.
+func (a *Adaptive) observe(diff float64) {
+	a.count++
+	delta := diff - a.mean
+	a.mean += delta / a.count
+	a.m2 += delta * (diff - a.mean)
+
+	if a.count < 2 {
+		return
+	}
+	sigma := math.Sqrt(a.m2 / (a.count - 1))
+	if sigma <= 0 {
+		return
+	}
+
+	z := (diff - a.mean) / sigma
+	cdf := 0.5 * (1 + math.Erf(z/math.Sqrt2))
+	phi := -math.Log10(1 - cdf)
+
+	if phi > a.Threshold {
+		a.n -= step(a.Smoothing, a.n-a.MinN)
+		if a.n < a.MinN {
+			a.n = a.MinN
+		}
+	} else {
+		a.n += step(a.Smoothing, a.MaxN-a.n)
+		if a.n > a.MaxN {
+			a.n = a.MaxN
+		}
+	}
+}
+
+// step returns the number of samples to move a window length by, rounding
+// smoothing*gap to the nearest integer but never returning less than 1 for
+// a positive gap, so that a sustained shrink or relax decision always
+// makes progress towards the bound instead of truncating to 0 and getting
+// stuck short of it.
+func step(smoothing float64, gap int) int {
+	if gap <= 0 {
+		return 0
+	}
+	s := int(math.Round(smoothing * float64(gap)))
+	if s < 1 {
+		s = 1
+	}
+	return s
+}
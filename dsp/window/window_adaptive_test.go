@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import "testing"
+
+func TestStepNeverTruncatesToZero(t *testing.T) {
+	for _, test := range []struct {
+		smoothing float64
+		gap       int
+		want      int
+	}{
+		{0.5, 1, 1},  // smoothing*gap == 0.5, would truncate to 0
+		{0.1, 1, 1},  // smoothing*gap == 0.1, would truncate to 0
+		{0.3, 10, 3}, // smoothing*gap == 3.0, no rounding needed
+		{0.37, 10, 4},
+		{1, 0, 0},
+		{1, -5, 0},
+	} {
+		if got := step(test.smoothing, test.gap); got != test.want {
+			t.Errorf("step(%v, %v) = %v, want %v", test.smoothing, test.gap, got, test.want)
+		}
+	}
+}
+
+// TestAdaptiveShrinksAndRelaxesWithNarrowBounds reproduces the scenario
+// that previously got the window length permanently stuck: MaxN-MinN==1
+// means the naive truncating step (int(Smoothing*1)) is always 0.
+func TestAdaptiveShrinksAndRelaxesWithNarrowBounds(t *testing.T) {
+	a := &Adaptive{
+		Base:      Gaussian{Sigma: 0.5},
+		MinN:      99,
+		MaxN:      100,
+		Threshold: 0.35,
+		Smoothing: 0.5,
+	}
+
+	n, _ := a.Next(100)
+	if n != a.MaxN {
+		t.Fatalf("first call: n = %v, want MaxN = %v", n, a.MaxN)
+	}
+
+	// Sustained, large energy swings should eventually be surprising
+	// enough to shrink the window toward MinN.
+	energies := []float64{200, 100, 200, 100, 200}
+	for _, e := range energies {
+		n, _ = a.Next(e)
+	}
+	if n != a.MinN {
+		t.Errorf("after sustained large energy swings, n = %v, want MinN = %v", n, a.MinN)
+	}
+
+	// A run of quiet, constant-energy frames should relax the window back
+	// toward MaxN.
+	for i := 0; i < 5; i++ {
+		n, _ = a.Next(100)
+	}
+	if n != a.MaxN {
+		t.Errorf("after quiet frames, n = %v, want MaxN = %v", n, a.MaxN)
+	}
+}
+
+func TestAdaptiveNextReturnsRequestedLengthCoefficients(t *testing.T) {
+	a := &Adaptive{
+		Base:      Gaussian{Sigma: 0.5},
+		MinN:      4,
+		MaxN:      8,
+		Threshold: 2,
+		Smoothing: 0.5,
+	}
+	n, w := a.Next(1)
+	if len(w) != n {
+		t.Errorf("len(w) = %v, want n = %v", len(w), n)
+	}
+	if n != a.MaxN {
+		t.Errorf("first call: n = %v, want MaxN = %v", n, a.MaxN)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import "math"
+
+// Parzen can modify a sequence by the Parzen window and return the result.
+// See https://en.wikipedia.org/wiki/Window_function#Parzen_window for
+// details.
+//
+// The Parzen window is a 4th-order B-spline approximation to the Gaussian
+// window. The sequence weights are, with M = N/2 and x = k-(N-1)/2,
+//  w[k] = 1 - 6*(x/M)²*(1-|x|/M),     |x| <= M/2,
+//  w[k] = 2*(1-|x|/M)³,               M/2 < |x| <= M,
+// for k=0,1,...,N-1 where N is the length of the window.
+//
+// Spectral leakage parameters, from F.J. Harris, "On the Use of Windows
+// for Harmonic Analysis with the DFT", Proc. IEEE 66(1), 1978, table I:
+//  ΔF_0   |   8.00
+//  ΔF_0.5 |   1.82
+//  K      |   1.92
+//  ɣ_max  | -53
+//  β      | -24
+func Parzen(seq []float64) []float64 {
+	m := float64(len(seq)) / 2
+	c := float64(len(seq)-1) / 2
+	for i := range seq {
+		seq[i] *= parzenWeight(float64(i)-c, m)
+	}
+	return seq
+}
+
+// ParzenComplex can modify a sequence by the Parzen window and return the
+// result.
+func ParzenComplex(seq []complex128) []complex128 {
+	m := float64(len(seq)) / 2
+	c := float64(len(seq)-1) / 2
+	for i, v := range seq {
+		w := parzenWeight(float64(i)-c, m)
+		seq[i] = complex(w*real(v), w*imag(v))
+	}
+	return seq
+}
+
+// parzenWeight returns the Parzen window weight at offset x from the
+// window center, where m is the window's half-width, N/2.
+func parzenWeight(x, m float64) float64 {
+	if m == 0 {
+		return 1
+	}
+	ax := math.Abs(x) / m
+	if ax <= 0.5 {
+		return 1 - 6*ax*ax*(1-ax)
+	}
+	return 2 * (1 - ax) * (1 - ax) * (1 - ax)
+}
+
+// Welch can modify a sequence by the Welch window and return the result.
+// See https://en.wikipedia.org/wiki/Window_function#Welch_window for
+// details.
+//
+// The sequence weights are
+//  w[k] = 1 - ((k-M)/M)², M = (N-1)/2,
+// for k=0,1,...,N-1 where N is the length of the window.
+//
+// Spectral leakage parameters, from F.J. Harris, "On the Use of Windows
+// for Harmonic Analysis with the DFT", Proc. IEEE 66(1), 1978, table I:
+//  ΔF_0   |   2.86
+//  ΔF_0.5 |   1.16
+//  K      |   1.20
+//  ɣ_max  | -21.3
+//  β      | -12
+func Welch(seq []float64) []float64 {
+	m := float64(len(seq)-1) / 2
+	for i := range seq {
+		x := (float64(i) - m) / m
+		seq[i] *= 1 - x*x
+	}
+	return seq
+}
+
+// WelchComplex can modify a sequence by the Welch window and return the
+// result.
+func WelchComplex(seq []complex128) []complex128 {
+	m := float64(len(seq)-1) / 2
+	for i, v := range seq {
+		x := (float64(i) - m) / m
+		w := 1 - x*x
+		seq[i] = complex(w*real(v), w*imag(v))
+	}
+	return seq
+}
+
+// Bartlett can modify a sequence by the Bartlett window and return the
+// result.
+// See https://en.wikipedia.org/wiki/Window_function#Triangular_window for
+// details.
+//
+// The Bartlett window is the triangular window with L=N-1, zero-valued at
+// both endpoints. The sequence weights are
+//  w[k] = 1 - |(k-M)/M|, M = (N-1)/2,
+// for k=0,1,...,N-1 where N is the length of the window.
+//
+// Spectral leakage parameters, from F.J. Harris, "On the Use of Windows
+// for Harmonic Analysis with the DFT", Proc. IEEE 66(1), 1978, table I:
+//  ΔF_0   |   4.00
+//  ΔF_0.5 |   1.28
+//  K      |   1.33
+//  ɣ_max  | -26.5
+//  β      | -12
+func Bartlett(seq []float64) []float64 {
+	m := float64(len(seq)-1) / 2
+	for i := range seq {
+		x := (float64(i) - m) / m
+		seq[i] *= 1 - math.Abs(x)
+	}
+	return seq
+}
+
+// BartlettComplex can modify a sequence by the Bartlett window and return
+// the result.
+func BartlettComplex(seq []complex128) []complex128 {
+	m := float64(len(seq)-1) / 2
+	for i, v := range seq {
+		x := (float64(i) - m) / m
+		w := 1 - math.Abs(x)
+		seq[i] = complex(w*real(v), w*imag(v))
+	}
+	return seq
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBartlett(t *testing.T) {
+	got := Bartlett(ones(5))
+	want := []float64{0, 0.5, 1, 0.5, 0}
+	checkWeights(t, "Bartlett", got, want, 1e-12)
+}
+
+func TestWelch(t *testing.T) {
+	got := Welch(ones(5))
+	want := []float64{0, 0.75, 1, 0.75, 0}
+	checkWeights(t, "Welch", got, want, 1e-12)
+}
+
+func TestParzen(t *testing.T) {
+	got := Parzen(ones(5))
+	want := []float64{0.016, 0.424, 1, 0.424, 0.016}
+	checkWeights(t, "Parzen", got, want, 1e-12)
+}
+
+// checkWeights compares got against want element-wise within tol.
+func checkWeights(t *testing.T, name string, got, want []float64, tol float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d weights, want %d", name, len(got), len(want))
+	}
+	for i, w := range want {
+		if math.Abs(got[i]-w) > tol {
+			t.Errorf("%s weight %d: got %v, want %v", name, i, got[i], w)
+		}
+	}
+}
+
+func TestFixedWindowsComplexMatchReal(t *testing.T) {
+	tests := []struct {
+		name    string
+		real    func([]float64) []float64
+		complex func([]complex128) []complex128
+	}{
+		{"Bartlett", Bartlett, BartlettComplex},
+		{"Welch", Welch, WelchComplex},
+		{"Parzen", Parzen, ParzenComplex},
+	}
+	const n = 7
+	for _, test := range tests {
+		wantReal := test.real(ones(n))
+		seq := make([]complex128, n)
+		for i := range seq {
+			seq[i] = complex(1, 0)
+		}
+		cplx := test.complex(seq)
+		for i, w := range wantReal {
+			if math.Abs(w-real(cplx[i])) > 1e-12 {
+				t.Errorf("%s: real and complex transforms disagree at %d: %v vs %v", test.name, i, w, cplx[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import "sync"
+
+// Window is implemented by types that can apply a window function to a
+// real or complex sequence in place, and can generate the coefficients
+// of the window for an arbitrary length and Mode without needing an
+// existing sequence to modify.
+type Window interface {
+	// Transform applies the window to seq in place, returning the result.
+	Transform(seq []float64) []float64
+	// TransformComplex applies the window to seq in place, returning the
+	// result.
+	TransformComplex(seq []complex128) []complex128
+	// Coefficients returns the n weights of the window in the given Mode.
+	Coefficients(n int, mode Mode) []float64
+}
+
+// Mode selects the form in which a Window generates its coefficients.
+type Mode int
+
+const (
+	// Symmetric is the classic N-point window whose first and last
+	// samples are equal, the form conventionally used for FIR filter
+	// design.
+	Symmetric Mode = iota
+	// Periodic is the DFT-symmetric form conventionally used for
+	// spectral analysis, such as STFT framing. It is computed as the
+	// Symmetric window of length n+1 with the last sample dropped, which
+	// avoids double-weighting the sample shared by overlapping frames.
+	Periodic
+)
+
+// ones returns a slice of n weights all equal to 1, suitable as the
+// identity input to a Transform.
+func ones(n int) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = 1
+	}
+	return v
+}
+
+// coefficients generates the n weights of a window by running transform,
+// a Transform-style application, over an identity
+// sequence of the length appropriate to mode. It is the shared
+// implementation behind every concrete Window's Coefficients method.
+func coefficients(transform func([]float64) []float64, n int, mode Mode) []float64 {
+	if mode == Periodic {
+		return transform(ones(n + 1))[:n]
+	}
+	return transform(ones(n))
+}
+
+// FuncWindow adapts a pair of functions with the signatures of Transform
+// and TransformComplex, such as Hann and HannComplex, to the Window
+// interface.
+type FuncWindow struct {
+	Transformer        func([]float64) []float64
+	ComplexTransformer func([]complex128) []complex128
+}
+
+// Transform applies f.Transformer to seq in place, returning the result.
+func (f FuncWindow) Transform(seq []float64) []float64 {
+	return f.Transformer(seq)
+}
+
+// TransformComplex applies f.ComplexTransformer to seq in place, returning
+// the result.
+func (f FuncWindow) TransformComplex(seq []complex128) []complex128 {
+	return f.ComplexTransformer(seq)
+}
+
+// Coefficients returns the n weights of the window in the given Mode.
+func (f FuncWindow) Coefficients(n int, mode Mode) []float64 {
+	return coefficients(f.Transform, n, mode)
+}
+
+// Predefined FuncWindow adapters for the fixed, non-adjustable windows in
+// this package.
+var (
+	HannWindow           = FuncWindow{Hann, HannComplex}
+	HammingWindow        = FuncWindow{Hamming, HammingComplex}
+	RectangularWindow    = FuncWindow{Rectangular, RectangularComplex}
+	BlackmanHarrisWindow = FuncWindow{BlackmanHarris, BlackmanHarrisComplex}
+	FlatTopWindow        = FuncWindow{FlatTop, FlatTopComplex}
+	ParzenWindow         = FuncWindow{Parzen, ParzenComplex}
+	WelchWindow          = FuncWindow{Welch, WelchComplex}
+	BartlettWindow       = FuncWindow{Bartlett, BartlettComplex}
+)
+
+// Cached wraps a Window and memoizes its coefficients by length and Mode,
+// so that repeated Transform/TransformComplex/Coefficients calls for
+// sequences of the same length do not recompute the underlying window
+// (e.g. re-running math.Exp or math.Cos per sample). This is intended for
+// callers, such as an STFT, that apply the same window to many frames of
+// the same length.
+//
+// Mode selects the Mode used by Transform and TransformComplex, which
+// have no way to pass a Mode explicitly since they operate on an
+// already-allocated sequence; Coefficients always takes its Mode as an
+// argument, as required by the Window interface, and caches independently
+// per Mode.
+//
+// A Cached must be created with NewCached; the zero value is not usable.
+type Cached struct {
+	win  Window
+	Mode Mode
+
+	mu    sync.Mutex
+	cache map[modeKey][]float64
+}
+
+// modeKey identifies a cached coefficients slice by length and Mode.
+type modeKey struct {
+	n    int
+	mode Mode
+}
+
+// NewCached returns a Cached that memoizes the coefficients of win.
+func NewCached(win Window) *Cached {
+	return &Cached{win: win, cache: make(map[modeKey][]float64)}
+}
+
+// coefficients returns the cached n-length coefficients of c for mode,
+// computing and storing them if they are not already cached.
+func (c *Cached) coefficients(n int, mode Mode) []float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := modeKey{n, mode}
+	if v, ok := c.cache[key]; ok {
+		return v
+	}
+	v := c.win.Coefficients(n, mode)
+	c.cache[key] = v
+	return v
+}
+
+// Coefficients returns a copy of the n weights of the underlying window
+// in the given Mode.
+func (c *Cached) Coefficients(n int, mode Mode) []float64 {
+	return append([]float64(nil), c.coefficients(n, mode)...)
+}
+
+// Transform applies the cached window weights, generated in c.Mode, to
+// seq in place, returning the result.
+func (c *Cached) Transform(seq []float64) []float64 {
+	w := c.coefficients(len(seq), c.Mode)
+	for i, x := range w {
+		seq[i] *= x
+	}
+	return seq
+}
+
+// TransformComplex applies the cached window weights, generated in
+// c.Mode, to seq in place, returning the result.
+func (c *Cached) TransformComplex(seq []complex128) []complex128 {
+	w := c.coefficients(len(seq), c.Mode)
+	for i, x := range w {
+		v := seq[i]
+		seq[i] = complex(x*real(v), x*imag(v))
+	}
+	return seq
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import (
+	"math"
+	"testing"
+)
+
+// countingWindow wraps Gaussian and counts calls to Coefficients, so tests
+// can observe whether Cached actually avoided recomputation.
+type countingWindow struct {
+	Gaussian
+	calls int
+}
+
+func (c *countingWindow) Coefficients(n int, mode Mode) []float64 {
+	c.calls++
+	return c.Gaussian.Coefficients(n, mode)
+}
+
+func TestCachedMatchesUnderlying(t *testing.T) {
+	w := Gaussian{Sigma: 0.5}
+	cached := NewCached(w)
+
+	for _, mode := range []Mode{Symmetric, Periodic} {
+		want := w.Coefficients(16, mode)
+		got := cached.Coefficients(16, mode)
+		if len(got) != len(want) {
+			t.Fatalf("mode %v: got %d weights, want %d", mode, len(got), len(want))
+		}
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-12 {
+				t.Errorf("mode %v weight %d: got %v, want %v", mode, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestCachedPeriodicMatchesSymmetricNPlus1(t *testing.T) {
+	w := Gaussian{Sigma: 0.5}
+	const n = 16
+	periodic := NewCached(w).Coefficients(n, Periodic)
+	symmetricNPlus1 := w.Coefficients(n+1, Symmetric)
+	for i := range periodic {
+		if math.Abs(periodic[i]-symmetricNPlus1[i]) > 1e-12 {
+			t.Errorf("weight %d: Periodic = %v, Symmetric(n+1)[:n] = %v", i, periodic[i], symmetricNPlus1[i])
+		}
+	}
+}
+
+func TestCachedMemoizesPerLengthAndMode(t *testing.T) {
+	cw := &countingWindow{Gaussian: Gaussian{Sigma: 0.5}}
+	cached := NewCached(cw)
+
+	cached.Coefficients(10, Symmetric)
+	cached.Coefficients(10, Symmetric)
+	if cw.calls != 1 {
+		t.Errorf("repeated Coefficients(10, Symmetric): underlying window called %d times, want 1", cw.calls)
+	}
+
+	cached.Coefficients(10, Periodic)
+	if cw.calls != 2 {
+		t.Errorf("Coefficients(10, Periodic): underlying window called %d times total, want 2", cw.calls)
+	}
+
+	cached.Coefficients(20, Symmetric)
+	if cw.calls != 3 {
+		t.Errorf("Coefficients(20, Symmetric): underlying window called %d times total, want 3", cw.calls)
+	}
+}
+
+func TestCachedTransformUsesMode(t *testing.T) {
+	w := Gaussian{Sigma: 0.5}
+	cached := NewCached(w)
+	cached.Mode = Periodic
+
+	seq := make([]float64, 8)
+	for i := range seq {
+		seq[i] = 1
+	}
+	got := cached.Transform(seq)
+	want := w.Coefficients(8, Periodic)
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("weight %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFuncWindowCoefficients(t *testing.T) {
+	// HannWindow adapts the fixed Hann window to the Window interface;
+	// Coefficients should agree with calling Hann directly.
+	want := Hann(ones(12))
+	got := HannWindow.Coefficients(12, Symmetric)
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("weight %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -54,6 +54,12 @@ func (g Gaussian) TransformComplex(seq []complex128) []complex128 {
 	return seq
 }
 
+// Coefficients returns the n weights of the Gaussian window in the given
+// Mode.
+func (g Gaussian) Coefficients(n int, mode Mode) []float64 {
+	return coefficients(g.Transform, n, mode)
+}
+
 // Tukey can modify a sequence using the Tukey window and return the result.
 // See https://en.wikipedia.org/wiki/Window_function#Tukey_window
 // and https://prod-ng.sandia.gov/techlib-noauth/access-control.cgi/2017/174042.pdf page 88
@@ -121,6 +127,88 @@ func (t Tukey) TransformComplex(seq []complex128) []complex128 {
 	return seq
 }
 
+// Coefficients returns the n weights of the Tukey window in the given
+// Mode.
+func (t Tukey) Coefficients(n int, mode Mode) []float64 {
+	return coefficients(t.Transform, n, mode)
+}
+
+// Kaiser can modify a sequence using the Kaiser window and return the result.
+// See https://en.wikipedia.org/wiki/Window_function#Kaiser_window
+// and https://www.recordingblogs.com/wiki/kaiser-window for details.
+//
+// The Kaiser window is an adjustable window.
+//
+// The sequence weights are
+//  w[k] = I₀(β*sqrt(1-((k-M)/M)²)) / I₀(β), M = (N-1)/2,
+// for k=0,1,...,N-1 where N is the length of the window and I₀ is the
+// zeroth order modified Bessel function of the first kind.
+//
+// The properties of the window depend on the value of β (beta). Larger
+// values of β narrow the main lobe and lower the sidelobes, trading
+// frequency resolution for leakage suppression.
+//
+// Spectral leakage parameters are summarized in the table:
+//         |  β=2    |  β=6   |  β=8.6 |
+//  -------|---------------------------|
+//  ΔF_0   |   2.37  |   4.32 |   5.84 |
+//  ΔF_0.5 |   0.99  |   1.41 |   1.64 |
+//  K      |   1.05  |   1.47 |   1.73 |
+//  ɣ_max  | -18.5   | -43.8  | -63.2  |
+//  β_roll |  -6     |  -6    |  -6    |
+type Kaiser struct {
+	Beta float64
+}
+
+// Transform applies the Kaiser transformation to seq in place, using the
+// value of the receiver as the beta parameter, and returning the result.
+func (k Kaiser) Transform(seq []float64) []float64 {
+	a := float64(len(seq)-1) / 2
+	i0Beta := besselI0(k.Beta)
+	for i := range seq {
+		x := (float64(i) - a) / a
+		seq[i] *= besselI0(k.Beta*math.Sqrt(1-x*x)) / i0Beta
+	}
+	return seq
+}
+
+// TransformComplex applies the Kaiser transformation to seq in place, using
+// the value of the receiver as the beta parameter, and returning the result.
+func (k Kaiser) TransformComplex(seq []complex128) []complex128 {
+	a := float64(len(seq)-1) / 2
+	i0Beta := besselI0(k.Beta)
+	for i, v := range seq {
+		x := (float64(i) - a) / a
+		w := besselI0(k.Beta*math.Sqrt(1-x*x)) / i0Beta
+		seq[i] = complex(w*real(v), w*imag(v))
+	}
+	return seq
+}
+
+// Coefficients returns the n weights of the Kaiser window in the given
+// Mode.
+func (k Kaiser) Coefficients(n int, mode Mode) []float64 {
+	return coefficients(k.Transform, n, mode)
+}
+
+// besselI0 returns the value of the zeroth order modified Bessel function
+// of the first kind at x, computed from its power series. The series
+// converges quickly for the values of x encountered in Kaiser window
+// computation and is summed until successive terms no longer change the
+// result.
+func besselI0(x float64) float64 {
+	halfXSq := (x / 2) * (x / 2)
+	sum, term := 1.0, 1.0
+	for k := 1; k < 64; k++ {
+		term *= halfXSq / (float64(k) * float64(k))
+		sum += term
+		if term < sum*1e-16 {
+			break
+		}
+	}
+	return sum
+}
+
 // Values is an arbitrary real window function.
 type Values []float64
 
@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package window
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBesselI0(t *testing.T) {
+	for _, test := range []struct {
+		x, want float64
+	}{
+		{0, 1},
+		{1, 1.2660658777520084},
+		{2, 2.2795853023360673},
+		{5, 27.239871823604442},
+	} {
+		if got := besselI0(test.x); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("besselI0(%v) = %v, want %v", test.x, got, test.want)
+		}
+	}
+}
+
+func TestKaiserBetaZeroIsRectangular(t *testing.T) {
+	got := Kaiser{Beta: 0}.Transform(ones(9))
+	for i, w := range got {
+		if math.Abs(w-1) > 1e-12 {
+			t.Errorf("Kaiser{Beta:0} weight %d = %v, want 1", i, w)
+		}
+	}
+}
+
+func TestKaiserSymmetric(t *testing.T) {
+	const n = 9
+	got := Kaiser{Beta: 6}.Transform(ones(n))
+	for i := 0; i < n/2; i++ {
+		if math.Abs(got[i]-got[n-1-i]) > 1e-12 {
+			t.Errorf("Kaiser not symmetric: weight %d = %v, weight %d = %v", i, got[i], n-1-i, got[n-1-i])
+		}
+	}
+	if center := got[n/2]; math.Abs(center-1) > 1e-12 {
+		t.Errorf("Kaiser center weight = %v, want 1", center)
+	}
+}